@@ -0,0 +1,197 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func NewDnsZoneDataSource() datasource.DataSource {
+	return &dnsZoneDataSource{}
+}
+
+type dnsZoneDataSource struct {
+	clt *bunny.Client
+}
+
+func (d *dnsZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *dnsZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("domain"),
+					),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"custom_nameservers": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled":      schema.BoolAttribute{Computed: true},
+					"soa_email":    schema.StringAttribute{Computed: true},
+					"nameserver_1": schema.StringAttribute{Computed: true},
+					"nameserver_2": schema.StringAttribute{Computed: true},
+				},
+			},
+			"logging": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled":                  schema.BoolAttribute{Computed: true},
+					"ip_anonymization_enabled": schema.BoolAttribute{Computed: true},
+					"ip_anonymization":         schema.StringAttribute{Computed: true},
+				},
+			},
+			"dnssec": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled":     schema.BoolAttribute{Computed: true},
+					"ds_record":   schema.StringAttribute{Computed: true},
+					"key_tag":     schema.Int64Attribute{Computed: true},
+					"algorithm":   schema.Int64Attribute{Computed: true},
+					"digest_type": schema.Int64Attribute{Computed: true},
+					"digest":      schema.StringAttribute{Computed: true},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dnsZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clt, ok := req.ProviderData.(*bunny.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("expected *bunny.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	d.clt = clt
+}
+
+func (d *dnsZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dnsZoneResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dnsZone *bunny.DNSZone
+
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Parsing id failed", err.Error())
+			return
+		}
+
+		zone, err := d.clt.DNSZone.Get(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Reading DNS zone failed", err.Error())
+			return
+		}
+
+		dnsZone = zone
+	} else {
+		domain := config.Domain.ValueString()
+
+		zone, err := d.findDnsZoneByDomain(ctx, domain)
+		if err != nil {
+			resp.Diagnostics.AddError("Finding DNS zone by domain failed", err.Error())
+			return
+		}
+
+		dnsZone = zone
+	}
+
+	var state dnsZoneResourceModel
+	dnsZoneToModel(dnsZone, &state)
+
+	dnssec, err := fetchDnssec(ctx, d.clt, *dnsZone.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading DNSSEC status failed", err.Error())
+		return
+	}
+	state.Dnssec = dnssec
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findDnsZoneByDomain paginates through all of DNSZone.List collecting the
+// zones whose domain matches case-insensitively, returning an error if zero
+// or more than one zone matches.
+func (d *dnsZoneDataSource) findDnsZoneByDomain(ctx context.Context, domain string) (*bunny.DNSZone, error) {
+	var matches []*bunny.DNSZone
+
+	opts := &bunny.PaginationOptions{Page: 1, PerPage: 100}
+
+	for {
+		page, err := d.clt.DNSZone.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, matchDnsZonesByDomain(page.Items, domain)...)
+
+		if page.HasMoreItemsToFetch == nil || !*page.HasMoreItemsToFetch {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return singleDnsZoneMatch(matches, domain)
+}
+
+// matchDnsZonesByDomain returns the zones in zones whose domain matches
+// domain case-insensitively.
+func matchDnsZonesByDomain(zones []*bunny.DNSZone, domain string) []*bunny.DNSZone {
+	var matches []*bunny.DNSZone
+
+	for _, zone := range zones {
+		if zone.Domain != nil && strings.EqualFold(*zone.Domain, domain) {
+			matches = append(matches, zone)
+		}
+	}
+
+	return matches
+}
+
+// singleDnsZoneMatch returns the sole element of matches, erroring if it is
+// empty or has more than one element.
+func singleDnsZoneMatch(matches []*bunny.DNSZone, domain string) (*bunny.DNSZone, error) {
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no DNS zone found for domain %q", domain)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d DNS zones found for domain %q, expected exactly one", len(matches), domain)
+	}
+}
@@ -0,0 +1,54 @@
+package framework
+
+import (
+	"testing"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func TestMatchDnsZonesByDomain(t *testing.T) {
+	zones := []*bunny.DNSZone{
+		{ID: int64ptr(1), Domain: strptr("example.com")},
+		{ID: int64ptr(2), Domain: strptr("Example.org")},
+		{ID: int64ptr(3), Domain: nil},
+	}
+
+	matches := matchDnsZonesByDomain(zones, "example.org")
+	if len(matches) != 1 || *matches[0].ID != 2 {
+		t.Errorf("matches = %+v, want a single match with ID 2", matches)
+	}
+}
+
+func TestSingleDnsZoneMatch(t *testing.T) {
+	zone := &bunny.DNSZone{ID: int64ptr(1)}
+
+	tests := []struct {
+		name    string
+		matches []*bunny.DNSZone
+		wantErr bool
+		want    *bunny.DNSZone
+	}{
+		{name: "none", matches: nil, wantErr: true},
+		{name: "one", matches: []*bunny.DNSZone{zone}, want: zone},
+		{name: "many", matches: []*bunny.DNSZone{zone, zone}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := singleDnsZoneMatch(tt.matches, "example.com")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package framework
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+// New returns the plugin-framework provider. Resources are migrated here
+// from the SDKv2 provider (internal/provider) one at a time; both are served
+// through the protocol 6 mux wired up in main.go.
+func New(version string) provider.Provider {
+	return &bunnyProvider{version: version}
+}
+
+type bunnyProvider struct {
+	version string
+}
+
+type bunnyProviderModel struct {
+	ApiKey types.String `tfsdk:"api_key"`
+}
+
+func (p *bunnyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "bunny"
+	resp.Version = p.version
+}
+
+func (p *bunnyProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (p *bunnyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data bunnyProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey := data.ApiKey.ValueString()
+	if apiKey == "" {
+		apiKey = os.Getenv("BUNNY_API_KEY")
+	}
+
+	clt := bunny.NewClient(apiKey)
+	resp.ResourceData = clt
+	resp.DataSourceData = clt
+}
+
+func (p *bunnyProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewDnsZoneResource,
+		NewDnsRecordResource,
+		NewDnsMonitorResource,
+	}
+}
+
+func (p *bunnyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewDnsZoneDataSource,
+	}
+}
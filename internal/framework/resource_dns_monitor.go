@@ -0,0 +1,226 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func NewDnsMonitorResource() resource.Resource {
+	return &dnsMonitorResource{}
+}
+
+type dnsMonitorResource struct {
+	clt *bunny.Client
+}
+
+type dnsMonitorResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	Interval         types.Int64  `tfsdk:"interval"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+	Threshold        types.Int64  `tfsdk:"threshold"`
+	ExpectedResponse types.String `tfsdk:"expected_response"`
+}
+
+func (r *dnsMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_monitor"
+}
+
+func (r *dnsMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Valid values: " + strings.Join(dnsMonitorTypeKeys, ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsMonitorTypeKeys...),
+				},
+			},
+			"interval": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(60),
+			},
+			"timeout": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(5),
+			},
+			"threshold": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3),
+			},
+			"expected_response": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *dnsMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clt, ok := req.ProviderData.(*bunny.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *bunny.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.clt = clt
+}
+
+func (r *dnsMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsMonitorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := dnsMonitorFromModel(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Converting plan to API type failed", err.Error())
+		return
+	}
+
+	added, err := r.clt.Monitor.Add(ctx, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError("Creating DNS monitor failed", err.Error())
+		return
+	}
+
+	dnsMonitorToModel(added, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsMonitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS monitor ID failed", err.Error())
+		return
+	}
+
+	monitor, err := r.clt.Monitor.Get(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading DNS monitor failed", err.Error())
+		return
+	}
+
+	dnsMonitorToModel(monitor, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsMonitorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS monitor ID failed", err.Error())
+		return
+	}
+
+	monitor, err := dnsMonitorFromModel(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Converting plan to API type failed", err.Error())
+		return
+	}
+
+	updated, err := r.clt.Monitor.Update(ctx, id, monitor)
+	if err != nil {
+		resp.Diagnostics.AddError("Updating DNS monitor failed", err.Error())
+		return
+	}
+
+	dnsMonitorToModel(updated, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsMonitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS monitor ID failed", err.Error())
+		return
+	}
+
+	if err := r.clt.Monitor.Delete(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Deleting DNS monitor failed", err.Error())
+	}
+}
+
+func (r *dnsMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func dnsMonitorToModel(monitor *bunny.Monitor, model *dnsMonitorResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(*monitor.ID, 10))
+	model.Type = types.StringValue(dnsMonitorTypesInt[monitor.Type])
+	model.Interval = types.Int64PointerValue(int64PtrFromInt32Ptr(monitor.Interval))
+	model.Timeout = types.Int64PointerValue(int64PtrFromInt32Ptr(monitor.Timeout))
+	model.Threshold = types.Int64PointerValue(int64PtrFromInt32Ptr(monitor.Threshold))
+	model.ExpectedResponse = types.StringPointerValue(monitor.ExpectedResponse)
+}
+
+func dnsMonitorFromModel(model *dnsMonitorResourceModel) (*bunny.Monitor, error) {
+	monitorType, exists := dnsMonitorTypesStr[model.Type.ValueString()]
+	if !exists {
+		return nil, fmt.Errorf("unsupported DNS monitor type: %q", model.Type.ValueString())
+	}
+
+	interval := int32(model.Interval.ValueInt64())
+	timeout := int32(model.Timeout.ValueInt64())
+	threshold := int32(model.Threshold.ValueInt64())
+
+	return &bunny.Monitor{
+		Type:             monitorType,
+		Interval:         &interval,
+		Timeout:          &timeout,
+		Threshold:        &threshold,
+		ExpectedResponse: model.ExpectedResponse.ValueStringPointer(),
+	}, nil
+}
+
+func int64PtrFromInt32Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	i := int64(*v)
+	return &i
+}
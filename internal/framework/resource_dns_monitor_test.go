@@ -0,0 +1,80 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func TestDnsMonitorFromModel(t *testing.T) {
+	model := &dnsMonitorResourceModel{
+		Type:             types.StringValue("http"),
+		Interval:         types.Int64Value(60),
+		Timeout:          types.Int64Value(5),
+		Threshold:        types.Int64Value(3),
+		ExpectedResponse: types.StringValue("200"),
+	}
+
+	monitor, err := dnsMonitorFromModel(model)
+	if err != nil {
+		t.Fatalf("dnsMonitorFromModel returned error: %v", err)
+	}
+
+	if monitor.Type != dnsMonitorTypesStr["http"] {
+		t.Errorf("Type = %d, want %d", monitor.Type, dnsMonitorTypesStr["http"])
+	}
+	if monitor.Interval == nil || *monitor.Interval != 60 {
+		t.Errorf("Interval = %v, want 60", monitor.Interval)
+	}
+	if monitor.Timeout == nil || *monitor.Timeout != 5 {
+		t.Errorf("Timeout = %v, want 5", monitor.Timeout)
+	}
+	if monitor.Threshold == nil || *monitor.Threshold != 3 {
+		t.Errorf("Threshold = %v, want 3", monitor.Threshold)
+	}
+	if monitor.ExpectedResponse == nil || *monitor.ExpectedResponse != "200" {
+		t.Errorf("ExpectedResponse = %v, want 200", monitor.ExpectedResponse)
+	}
+}
+
+func TestDnsMonitorFromModelUnsupportedType(t *testing.T) {
+	model := &dnsMonitorResourceModel{Type: types.StringValue("bogus")}
+
+	if _, err := dnsMonitorFromModel(model); err == nil {
+		t.Fatal("expected an error for an unsupported monitor type, got nil")
+	}
+}
+
+func TestDnsMonitorToModel(t *testing.T) {
+	monitor := &bunny.Monitor{
+		ID:               int64ptr(1),
+		Type:             dnsMonitorTypesStr["ping"],
+		Interval:         int32ptr(30),
+		Timeout:          int32ptr(10),
+		Threshold:        int32ptr(2),
+		ExpectedResponse: strptr("OK"),
+	}
+
+	var model dnsMonitorResourceModel
+	dnsMonitorToModel(monitor, &model)
+
+	if model.Type.ValueString() != "ping" {
+		t.Errorf("Type = %q, want ping", model.Type.ValueString())
+	}
+	if model.Interval.ValueInt64() != 30 {
+		t.Errorf("Interval = %d, want 30", model.Interval.ValueInt64())
+	}
+	if model.Timeout.ValueInt64() != 10 {
+		t.Errorf("Timeout = %d, want 10", model.Timeout.ValueInt64())
+	}
+	if model.Threshold.ValueInt64() != 2 {
+		t.Errorf("Threshold = %d, want 2", model.Threshold.ValueInt64())
+	}
+	if model.ExpectedResponse.ValueString() != "OK" {
+		t.Errorf("ExpectedResponse = %q, want OK", model.ExpectedResponse.ValueString())
+	}
+}
+
+func int32ptr(v int32) *int32 { return &v }
@@ -0,0 +1,443 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func NewDnsRecordResource() resource.Resource {
+	return &dnsRecordResource{}
+}
+
+type dnsRecordResource struct {
+	clt *bunny.Client
+}
+
+type dnsRecordResourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	ZoneID      types.String            `tfsdk:"zone_id"`
+	Type        types.String            `tfsdk:"type"`
+	Name        types.String            `tfsdk:"name"`
+	Value       types.String            `tfsdk:"value"`
+	TTL         types.Int64             `tfsdk:"ttl"`
+	Weight      types.Int64             `tfsdk:"weight"`
+	Priority    types.Int64             `tfsdk:"priority"`
+	Port        types.Int64             `tfsdk:"port"`
+	Flags       types.Int64             `tfsdk:"flags"`
+	GeoLocation []geoLocationEntryModel `tfsdk:"geo_location"`
+	Latency     types.Bool              `tfsdk:"latency"`
+	Weighted    []weightedEntryModel    `tfsdk:"weighted"`
+	Failover    *failoverModel          `tfsdk:"failover"`
+}
+
+type geoLocationEntryModel struct {
+	Country   types.String `tfsdk:"country"`
+	Continent types.String `tfsdk:"continent"`
+	Value     types.String `tfsdk:"value"`
+}
+
+type weightedEntryModel struct {
+	Value  types.String `tfsdk:"value"`
+	Weight types.Int64  `tfsdk:"weight"`
+}
+
+type failoverModel struct {
+	MonitorID types.String `tfsdk:"monitor_id"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *dnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *dnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Valid values: " + strings.Join(dnsRecordTypeKeys, ", "),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsRecordTypeKeys...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"value": schema.StringAttribute{
+				Optional: true,
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(300),
+			},
+			"weight": schema.Int64Attribute{
+				Optional: true,
+			},
+			"priority": schema.Int64Attribute{
+				Optional: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+			},
+			"flags": schema.Int64Attribute{
+				Optional: true,
+			},
+			"latency": schema.BoolAttribute{
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(
+						path.MatchRoot("geo_location"),
+						path.MatchRoot("weighted"),
+						path.MatchRoot("failover"),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"geo_location": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"country": schema.StringAttribute{
+							Optional: true,
+						},
+						"continent": schema.StringAttribute{
+							Optional: true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(
+						path.MatchRoot("latency"),
+						path.MatchRoot("weighted"),
+						path.MatchRoot("failover"),
+					),
+				},
+			},
+			"weighted": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+						"weight": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(
+						path.MatchRoot("geo_location"),
+						path.MatchRoot("latency"),
+						path.MatchRoot("failover"),
+					),
+				},
+			},
+			"failover": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"monitor_id": schema.StringAttribute{
+						Required: true,
+					},
+					"value": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("geo_location"),
+						path.MatchRoot("latency"),
+						path.MatchRoot("weighted"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *dnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clt, ok := req.ProviderData.(*bunny.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *bunny.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.clt = clt
+}
+
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID, err := strconv.ParseInt(plan.ZoneID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing zone_id failed", err.Error())
+		return
+	}
+
+	record, err := dnsRecordFromModel(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Converting plan to API type failed", err.Error())
+		return
+	}
+
+	added, err := r.clt.DNSZone.AddRecord(ctx, zoneID, record)
+	if err != nil {
+		resp.Diagnostics.AddError("Creating DNS record failed", err.Error())
+		return
+	}
+
+	dnsRecordToModel(added, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID, recordID, err := dnsRecordModelIDs(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS record ID failed", err.Error())
+		return
+	}
+
+	dnsZone, err := r.clt.DNSZone.Get(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading DNS zone failed", err.Error())
+		return
+	}
+
+	for _, record := range dnsZone.Records {
+		if record.ID != nil && *record.ID == recordID {
+			dnsRecordToModel(record, &state)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID, recordID, err := dnsRecordModelIDs(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS record ID failed", err.Error())
+		return
+	}
+
+	record, err := dnsRecordFromModel(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Converting plan to API type failed", err.Error())
+		return
+	}
+
+	updated, err := r.clt.DNSZone.UpdateRecord(ctx, zoneID, recordID, record)
+	if err != nil {
+		resp.Diagnostics.AddError("Updating DNS record failed", err.Error())
+		return
+	}
+
+	dnsRecordToModel(updated, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID, recordID, err := dnsRecordModelIDs(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS record ID failed", err.Error())
+		return
+	}
+
+	if err := r.clt.DNSZone.DeleteRecord(ctx, zoneID, recordID); err != nil {
+		resp.Diagnostics.AddError("Deleting DNS record failed", err.Error())
+	}
+}
+
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("expected <zone_id>/<record_id>, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func dnsRecordModelIDs(model *dnsRecordResourceModel) (zoneID, recordID int64, err error) {
+	zoneID, err = strconv.ParseInt(model.ZoneID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing zone_id failed: %w", err)
+	}
+
+	recordID, err = strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing record ID failed: %w", err)
+	}
+
+	return zoneID, recordID, nil
+}
+
+func dnsRecordToModel(record *bunny.DNSRecord, model *dnsRecordResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(*record.ID, 10))
+	model.Type = types.StringValue(dnsRecordTypesInt[record.Type])
+	model.Name = types.StringPointerValue(record.Name)
+	model.Value = types.StringPointerValue(record.Value)
+	model.TTL = types.Int64PointerValue(int64PtrFromInt32Ptr(record.TTL))
+	model.Weight = types.Int64PointerValue(int64PtrFromInt32Ptr(record.Weight))
+	model.Priority = types.Int64PointerValue(int64PtrFromInt32Ptr(record.Priority))
+	model.Port = types.Int64PointerValue(int64PtrFromInt32Ptr(record.Port))
+	model.Flags = types.Int64PointerValue(int64PtrFromInt32Ptr(record.Flags))
+
+	model.GeoLocation = nil
+	model.Latency = types.BoolValue(false)
+	model.Weighted = nil
+	model.Failover = nil
+
+	switch dnsSmartRoutingTypesInt[record.SmartRoutingType] {
+	case "geolocation":
+		for _, entry := range record.GeoLocationEntries {
+			model.GeoLocation = append(model.GeoLocation, geoLocationEntryModel{
+				Country:   types.StringValue(entry.Country),
+				Continent: types.StringValue(entry.Continent),
+				Value:     types.StringValue(entry.Value),
+			})
+		}
+	case "latency":
+		model.Latency = types.BoolValue(true)
+	case "weighted":
+		for _, entry := range record.WeightedEntries {
+			model.Weighted = append(model.Weighted, weightedEntryModel{
+				Value:  types.StringValue(entry.Value),
+				Weight: types.Int64Value(int64(entry.Weight)),
+			})
+		}
+	case "failover":
+		var monitorID types.String
+		if record.MonitorID != nil {
+			monitorID = types.StringValue(strconv.FormatInt(*record.MonitorID, 10))
+		} else {
+			monitorID = types.StringNull()
+		}
+
+		model.Failover = &failoverModel{
+			MonitorID: monitorID,
+			Value:     types.StringPointerValue(record.Value),
+		}
+	}
+}
+
+func dnsRecordFromModel(model *dnsRecordResourceModel) (*bunny.DNSRecord, error) {
+	recordType, exists := dnsRecordTypesStr[model.Type.ValueString()]
+	if !exists {
+		return nil, fmt.Errorf("unsupported DNS record type: %q", model.Type.ValueString())
+	}
+
+	record := &bunny.DNSRecord{
+		Type:             recordType,
+		Name:             model.Name.ValueStringPointer(),
+		Value:            model.Value.ValueStringPointer(),
+		SmartRoutingType: dnsSmartRoutingTypesStr["none"],
+	}
+
+	ttl := int32(model.TTL.ValueInt64())
+	record.TTL = &ttl
+	weight := int32(model.Weight.ValueInt64())
+	record.Weight = &weight
+	priority := int32(model.Priority.ValueInt64())
+	record.Priority = &priority
+	port := int32(model.Port.ValueInt64())
+	record.Port = &port
+	flags := int32(model.Flags.ValueInt64())
+	record.Flags = &flags
+
+	switch {
+	case len(model.GeoLocation) > 0:
+		record.SmartRoutingType = dnsSmartRoutingTypesStr["geolocation"]
+		for _, entry := range model.GeoLocation {
+			record.GeoLocationEntries = append(record.GeoLocationEntries, bunny.DNSRecordGeoLocationEntry{
+				Country:   entry.Country.ValueString(),
+				Continent: entry.Continent.ValueString(),
+				Value:     entry.Value.ValueString(),
+			})
+		}
+	case model.Latency.ValueBool():
+		record.SmartRoutingType = dnsSmartRoutingTypesStr["latency"]
+	case len(model.Weighted) > 0:
+		record.SmartRoutingType = dnsSmartRoutingTypesStr["weighted"]
+		for _, entry := range model.Weighted {
+			record.WeightedEntries = append(record.WeightedEntries, bunny.DNSRecordWeightedEntry{
+				Value:  entry.Value.ValueString(),
+				Weight: int32(entry.Weight.ValueInt64()),
+			})
+		}
+	case model.Failover != nil:
+		record.SmartRoutingType = dnsSmartRoutingTypesStr["failover"]
+
+		monitorID, err := strconv.ParseInt(model.Failover.MonitorID.ValueString(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing monitor_id failed: %w", err)
+		}
+		record.MonitorID = &monitorID
+		record.Value = model.Failover.Value.ValueStringPointer()
+	}
+
+	return record, nil
+}
@@ -0,0 +1,130 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func int64ptr(v int64) *int64 { return &v }
+func strptr(v string) *string { return &v }
+
+func TestDnsRecordFromModel(t *testing.T) {
+	model := &dnsRecordResourceModel{
+		Type:     types.StringValue("a"),
+		Name:     types.StringValue("www"),
+		Value:    types.StringValue("192.0.2.1"),
+		TTL:      types.Int64Value(300),
+		Weight:   types.Int64Value(1),
+		Priority: types.Int64Value(2),
+		Port:     types.Int64Value(3),
+		Flags:    types.Int64Value(4),
+	}
+
+	record, err := dnsRecordFromModel(model)
+	if err != nil {
+		t.Fatalf("dnsRecordFromModel returned error: %v", err)
+	}
+
+	if record.Type != dnsRecordTypesStr["a"] {
+		t.Errorf("Type = %d, want %d", record.Type, dnsRecordTypesStr["a"])
+	}
+	if record.SmartRoutingType != dnsSmartRoutingTypesStr["none"] {
+		t.Errorf("SmartRoutingType = %d, want none", record.SmartRoutingType)
+	}
+	if record.Name == nil || *record.Name != "www" {
+		t.Errorf("Name = %v, want www", record.Name)
+	}
+	if record.TTL == nil || *record.TTL != 300 {
+		t.Errorf("TTL = %v, want 300", record.TTL)
+	}
+}
+
+func TestDnsRecordFromModelUnsupportedType(t *testing.T) {
+	model := &dnsRecordResourceModel{Type: types.StringValue("bogus")}
+
+	if _, err := dnsRecordFromModel(model); err == nil {
+		t.Fatal("expected an error for an unsupported record type, got nil")
+	}
+}
+
+func TestDnsRecordToModelSmartRouting(t *testing.T) {
+	tests := []struct {
+		name   string
+		record *bunny.DNSRecord
+		check  func(t *testing.T, model *dnsRecordResourceModel)
+	}{
+		{
+			name: "geolocation",
+			record: &bunny.DNSRecord{
+				ID:               int64ptr(1),
+				Type:             dnsRecordTypesStr["a"],
+				SmartRoutingType: dnsSmartRoutingTypesStr["geolocation"],
+				GeoLocationEntries: []bunny.DNSRecordGeoLocationEntry{
+					{Country: "SE", Continent: "EU", Value: "192.0.2.1"},
+				},
+			},
+			check: func(t *testing.T, model *dnsRecordResourceModel) {
+				if len(model.GeoLocation) != 1 || model.GeoLocation[0].Value.ValueString() != "192.0.2.1" {
+					t.Errorf("GeoLocation = %+v, want one entry with value 192.0.2.1", model.GeoLocation)
+				}
+			},
+		},
+		{
+			name: "latency",
+			record: &bunny.DNSRecord{
+				ID:               int64ptr(2),
+				Type:             dnsRecordTypesStr["a"],
+				SmartRoutingType: dnsSmartRoutingTypesStr["latency"],
+			},
+			check: func(t *testing.T, model *dnsRecordResourceModel) {
+				if !model.Latency.ValueBool() {
+					t.Error("Latency = false, want true")
+				}
+			},
+		},
+		{
+			name: "weighted",
+			record: &bunny.DNSRecord{
+				ID:               int64ptr(3),
+				Type:             dnsRecordTypesStr["a"],
+				SmartRoutingType: dnsSmartRoutingTypesStr["weighted"],
+				WeightedEntries: []bunny.DNSRecordWeightedEntry{
+					{Value: "192.0.2.1", Weight: 10},
+				},
+			},
+			check: func(t *testing.T, model *dnsRecordResourceModel) {
+				if len(model.Weighted) != 1 || model.Weighted[0].Weight.ValueInt64() != 10 {
+					t.Errorf("Weighted = %+v, want one entry with weight 10", model.Weighted)
+				}
+			},
+		},
+		{
+			name: "failover without monitor",
+			record: &bunny.DNSRecord{
+				ID:               int64ptr(4),
+				Type:             dnsRecordTypesStr["a"],
+				SmartRoutingType: dnsSmartRoutingTypesStr["failover"],
+				Value:            strptr("192.0.2.1"),
+			},
+			check: func(t *testing.T, model *dnsRecordResourceModel) {
+				if model.Failover == nil {
+					t.Fatal("Failover = nil, want non-nil")
+				}
+				if !model.Failover.MonitorID.IsNull() {
+					t.Errorf("MonitorID = %v, want null", model.Failover.MonitorID)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &dnsRecordResourceModel{}
+			dnsRecordToModel(tt.record, model)
+			tt.check(t, model)
+		})
+	}
+}
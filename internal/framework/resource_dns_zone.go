@@ -0,0 +1,428 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func NewDnsZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+type dnsZoneResource struct {
+	clt *bunny.Client
+}
+
+type dnsZoneResourceModel struct {
+	ID                types.String            `tfsdk:"id"`
+	Domain            types.String            `tfsdk:"domain"`
+	CustomNameservers *customNameserversModel `tfsdk:"custom_nameservers"`
+	Logging           *dnsZoneLoggingModel    `tfsdk:"logging"`
+	Dnssec            *dnssecModel            `tfsdk:"dnssec"`
+	LastUpdated       types.String            `tfsdk:"last_updated"`
+}
+
+type dnssecModel struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	DsRecord   types.String `tfsdk:"ds_record"`
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+}
+
+type customNameserversModel struct {
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	SoaEmail    types.String `tfsdk:"soa_email"`
+	Nameserver1 types.String `tfsdk:"nameserver_1"`
+	Nameserver2 types.String `tfsdk:"nameserver_2"`
+}
+
+type dnsZoneLoggingModel struct {
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	IpAnonymizationEnabled types.Bool   `tfsdk:"ip_anonymization_enabled"`
+	IpAnonymization        types.String `tfsdk:"ip_anonymization"`
+}
+
+func (r *dnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *dnsZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_nameservers": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"soa_email": schema.StringAttribute{
+						Required: true,
+					},
+					"nameserver_1": schema.StringAttribute{
+						Required: true,
+					},
+					"nameserver_2": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			"logging": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"ip_anonymization_enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"ip_anonymization": schema.StringAttribute{
+						Optional:    true,
+						Description: "Valid values: " + strings.Join(dnsZoneLoggingAnonymizationTypeKeys, ", "),
+						Validators: []validator.String{
+							stringvalidator.OneOf(dnsZoneLoggingAnonymizationTypeKeys...),
+						},
+					},
+				},
+			},
+			"dnssec": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required: true,
+					},
+					"ds_record": schema.StringAttribute{
+						Computed: true,
+					},
+					"key_tag": schema.Int64Attribute{
+						Computed: true,
+					},
+					"algorithm": schema.Int64Attribute{
+						Computed: true,
+					},
+					"digest_type": schema.Int64Attribute{
+						Computed: true,
+					},
+					"digest": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *dnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clt, ok := req.ProviderData.(*bunny.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *bunny.Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.clt = clt
+}
+
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsZone, err := r.clt.DNSZone.Add(ctx, &bunny.DNSZone{
+		Domain: plan.Domain.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Creating DNS zone failed", err.Error())
+		return
+	}
+
+	updated, diags := r.update(ctx, *dnsZone.ID, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsZoneToModel(updated, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// a just-created zone has never had DNSSEC enabled, so there's nothing to
+	// disable if it's not requested in the plan.
+	resp.Diagnostics.Append(r.syncDnssec(ctx, *dnsZone.ID, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS zone ID failed", err.Error())
+		return
+	}
+
+	dnsZone, err := r.clt.DNSZone.Get(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading DNS zone failed", err.Error())
+		return
+	}
+
+	dnsZoneToModel(dnsZone, &state)
+
+	dnssec, err := fetchDnssec(ctx, r.clt, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Reading DNSSEC status failed", err.Error())
+		return
+	}
+	state.Dnssec = dnssec
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorState dnsZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS zone ID failed", err.Error())
+		return
+	}
+
+	updated, diags := r.update(ctx, id, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsZoneToModel(updated, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	wasEnabled := priorState.Dnssec != nil && priorState.Dnssec.Enabled.ValueBool()
+
+	resp.Diagnostics.Append(r.syncDnssec(ctx, id, &plan, wasEnabled)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Parsing DNS zone ID failed", err.Error())
+		return
+	}
+
+	if err := r.clt.DNSZone.Delete(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Deleting DNS zone failed", err.Error())
+	}
+}
+
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// update pushes the custom_nameservers and logging blocks from plan to the
+// API, since DNSZone.Add only accepts the domain.
+func (r *dnsZoneResource) update(ctx context.Context, id int64, plan *dnsZoneResourceModel) (*bunny.DNSZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := &bunny.DNSZoneUpdateOptions{}
+
+	if plan.CustomNameservers != nil {
+		opts.CustomNameserversEnabled = true
+		opts.Nameserver1 = plan.CustomNameservers.Nameserver1.ValueStringPointer()
+		opts.Nameserver2 = plan.CustomNameservers.Nameserver2.ValueStringPointer()
+		opts.SoaEmail = plan.CustomNameservers.SoaEmail.ValueStringPointer()
+	} else {
+		opts.CustomNameserversEnabled = false
+	}
+
+	if plan.Logging != nil {
+		opts.LoggingEnabled = true
+
+		anonymizationType, exists := dnsZoneLoggingAnonymizationTypesStr[plan.Logging.IpAnonymization.ValueString()]
+		if exists {
+			opts.LoggingIPAnonymizationEnabled = true
+			opts.LogAnonymizationType = anonymizationType
+		}
+	} else {
+		opts.LoggingEnabled = false
+	}
+
+	dnsZone, err := r.clt.DNSZone.Update(ctx, id, opts)
+	if err != nil {
+		diags.AddError("Updating DNS zone failed", err.Error())
+		return nil, diags
+	}
+
+	return dnsZone, diags
+}
+
+// syncDnssec enables or disables DNSSEC on the zone according to plan, and
+// for a newly enabled zone waits for the DS record to become available, the
+// API needs a short while to generate it after enabling.
+func (r *dnsZoneResource) syncDnssec(ctx context.Context, id int64, plan *dnsZoneResourceModel, wasEnabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.Dnssec == nil || !plan.Dnssec.Enabled.ValueBool() {
+		// only call the disable endpoint if DNSSEC was actually turned on
+		// before, so zones that never touch the dnssec block don't trigger a
+		// disable call on every create/update.
+		if wasEnabled {
+			if err := r.clt.DNSZone.DisableDnssec(ctx, id); err != nil {
+				diags.AddError("Disabling DNSSEC failed", err.Error())
+			}
+		}
+
+		plan.Dnssec = nil
+
+		return diags
+	}
+
+	if _, err := r.clt.DNSZone.EnableDnssec(ctx, id); err != nil {
+		diags.AddError("Enabling DNSSEC failed", err.Error())
+		return diags
+	}
+
+	var ds *bunny.DNSZoneDnssec
+
+	err := sdkresource.RetryContext(ctx, 2*time.Minute, func() *sdkresource.RetryError {
+		got, err := r.clt.DNSZone.GetDnssec(ctx, id)
+		if err != nil {
+			return sdkresource.NonRetryableError(err)
+		}
+
+		if got.DsRecord == nil {
+			return sdkresource.RetryableError(fmt.Errorf("DS record is not available yet"))
+		}
+
+		ds = got
+
+		return nil
+	})
+	if err != nil {
+		diags.AddError("Waiting for DS record failed", err.Error())
+		return diags
+	}
+
+	plan.Dnssec = dnssecToModel(ds)
+
+	return diags
+}
+
+// fetchDnssec fetches the current DNSSEC status of a zone, without retrying
+// for a DS record that may never appear because DNSSEC is disabled.
+func fetchDnssec(ctx context.Context, clt *bunny.Client, id int64) (*dnssecModel, error) {
+	ds, err := clt.DNSZone.GetDnssec(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ds.Enabled {
+		return nil, nil
+	}
+
+	return dnssecToModel(ds), nil
+}
+
+func dnssecToModel(ds *bunny.DNSZoneDnssec) *dnssecModel {
+	return &dnssecModel{
+		Enabled:    types.BoolValue(true),
+		DsRecord:   types.StringPointerValue(ds.DsRecord),
+		KeyTag:     types.Int64PointerValue(int64PtrFromInt32Ptr(ds.KeyTag)),
+		Algorithm:  types.Int64PointerValue(int64PtrFromInt32Ptr(ds.Algorithm)),
+		DigestType: types.Int64PointerValue(int64PtrFromInt32Ptr(ds.DigestType)),
+		Digest:     types.StringPointerValue(ds.Digest),
+	}
+}
+
+func dnsZoneToModel(dnsZone *bunny.DNSZone, model *dnsZoneResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(*dnsZone.ID, 10))
+	model.Domain = types.StringPointerValue(dnsZone.Domain)
+
+	if dnsZone.CustomNameserversEnabled {
+		model.CustomNameservers = &customNameserversModel{
+			Enabled:     types.BoolValue(dnsZone.CustomNameserversEnabled),
+			SoaEmail:    types.StringPointerValue(dnsZone.SoaEmail),
+			Nameserver1: types.StringPointerValue(dnsZone.Nameserver1),
+			Nameserver2: types.StringPointerValue(dnsZone.Nameserver2),
+		}
+	} else {
+		model.CustomNameservers = nil
+	}
+
+	if dnsZone.LoggingEnabled {
+		anonymizationType := dnsZoneLoggingAnonymizationTypesInt[dnsZone.LogAnonymizationType]
+
+		model.Logging = &dnsZoneLoggingModel{
+			Enabled:                types.BoolValue(dnsZone.LoggingEnabled),
+			IpAnonymizationEnabled: types.BoolValue(dnsZone.LoggingIPAnonymizationEnabled),
+			IpAnonymization:        types.StringValue(anonymizationType),
+		}
+	} else {
+		model.Logging = nil
+	}
+}
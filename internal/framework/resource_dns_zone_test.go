@@ -0,0 +1,89 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+func TestDnssecToModel(t *testing.T) {
+	ds := &bunny.DNSZoneDnssec{
+		Enabled:    true,
+		DsRecord:   strptr("example.com. IN DS 1 1 1 abc123"),
+		KeyTag:     int32ptr(1),
+		Algorithm:  int32ptr(13),
+		DigestType: int32ptr(2),
+		Digest:     strptr("abc123"),
+	}
+
+	model := dnssecToModel(ds)
+
+	if !model.Enabled.ValueBool() {
+		t.Error("Enabled = false, want true")
+	}
+	if model.DsRecord.ValueString() != *ds.DsRecord {
+		t.Errorf("DsRecord = %q, want %q", model.DsRecord.ValueString(), *ds.DsRecord)
+	}
+	if model.KeyTag.ValueInt64() != 1 {
+		t.Errorf("KeyTag = %d, want 1", model.KeyTag.ValueInt64())
+	}
+	if model.Algorithm.ValueInt64() != 13 {
+		t.Errorf("Algorithm = %d, want 13", model.Algorithm.ValueInt64())
+	}
+	if model.DigestType.ValueInt64() != 2 {
+		t.Errorf("DigestType = %d, want 2", model.DigestType.ValueInt64())
+	}
+	if model.Digest.ValueString() != *ds.Digest {
+		t.Errorf("Digest = %q, want %q", model.Digest.ValueString(), *ds.Digest)
+	}
+}
+
+func TestDnsZoneToModel(t *testing.T) {
+	dnsZone := &bunny.DNSZone{
+		ID:                            int64ptr(1),
+		Domain:                        strptr("example.com"),
+		CustomNameserversEnabled:      true,
+		SoaEmail:                      strptr("hostmaster@example.com"),
+		Nameserver1:                   strptr("ns1.example.com"),
+		Nameserver2:                   strptr("ns2.example.com"),
+		LoggingEnabled:                true,
+		LoggingIPAnonymizationEnabled: true,
+		LogAnonymizationType:          dnsZoneLoggingAnonymizationTypesStr["drop_ip"],
+	}
+
+	var model dnsZoneResourceModel
+	dnsZoneToModel(dnsZone, &model)
+
+	if model.Domain.ValueString() != "example.com" {
+		t.Errorf("Domain = %q, want example.com", model.Domain.ValueString())
+	}
+	if model.CustomNameservers == nil || model.CustomNameservers.Nameserver1.ValueString() != "ns1.example.com" {
+		t.Errorf("CustomNameservers = %+v, want nameserver_1 ns1.example.com", model.CustomNameservers)
+	}
+	if model.Logging == nil || model.Logging.IpAnonymization.ValueString() != "drop_ip" {
+		t.Errorf("Logging = %+v, want ip_anonymization drop_ip", model.Logging)
+	}
+}
+
+func TestDnsZoneToModelClearsDisabledBlocks(t *testing.T) {
+	model := dnsZoneResourceModel{
+		CustomNameservers: &customNameserversModel{Nameserver1: types.StringValue("ns1.example.com")},
+		Logging:           &dnsZoneLoggingModel{IpAnonymization: types.StringValue("drop_ip")},
+	}
+
+	dnsZoneToModel(&bunny.DNSZone{
+		ID:                       int64ptr(1),
+		Domain:                   strptr("example.com"),
+		CustomNameserversEnabled: false,
+		LoggingEnabled:           false,
+	}, &model)
+
+	if model.CustomNameservers != nil {
+		t.Errorf("CustomNameservers = %+v, want nil", model.CustomNameservers)
+	}
+	if model.Logging != nil {
+		t.Errorf("Logging = %+v, want nil", model.Logging)
+	}
+}
@@ -0,0 +1,75 @@
+package framework
+
+import "sort"
+
+// reverseStrIntMap returns the inverse of m, mapping each int value back to
+// its string key.
+func reverseStrIntMap(m map[string]int) map[int]string {
+	out := make(map[int]string, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+
+	return out
+}
+
+// strIntMapKeysSorted returns the keys of m sorted alphabetically, for use in
+// schema descriptions and OneOf validators.
+func strIntMapKeysSorted(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+var dnsZoneLoggingAnonymizationTypesStr = map[string]int{
+	"remove_octet": 0,
+	"drop_ip":      1,
+}
+
+var dnsZoneLoggingAnonymizationTypesInt = reverseStrIntMap(dnsZoneLoggingAnonymizationTypesStr)
+
+var dnsZoneLoggingAnonymizationTypeKeys = strIntMapKeysSorted(dnsZoneLoggingAnonymizationTypesStr)
+
+var dnsRecordTypesStr = map[string]int{
+	"a":         0,
+	"aaaa":      1,
+	"cname":     2,
+	"txt":       3,
+	"mx":        4,
+	"redirect":  5,
+	"flatten":   6,
+	"pull_zone": 7,
+	"srv":       8,
+	"caa":       9,
+	"ptr":       10,
+	"script":    11,
+	"ns":        12,
+}
+
+var dnsRecordTypesInt = reverseStrIntMap(dnsRecordTypesStr)
+
+var dnsRecordTypeKeys = strIntMapKeysSorted(dnsRecordTypesStr)
+
+var dnsSmartRoutingTypesStr = map[string]int{
+	"none":        0,
+	"geolocation": 1,
+	"latency":     2,
+	"weighted":    3,
+	"failover":    4,
+}
+
+var dnsSmartRoutingTypesInt = reverseStrIntMap(dnsSmartRoutingTypesStr)
+
+var dnsMonitorTypesStr = map[string]int{
+	"http": 0,
+	"ping": 1,
+}
+
+var dnsMonitorTypesInt = reverseStrIntMap(dnsMonitorTypesStr)
+
+var dnsMonitorTypeKeys = strIntMapKeysSorted(dnsMonitorTypesStr)
@@ -1,10 +0,0 @@
-package provider
-
-var dnsZoneLoggingAnonymizationTypesStr = map[string]int{
-	"remove_octet": 0,
-	"drop_ip":      1,
-}
-
-var dnsZoneLoggingAnonymizationTypesInt = reverseStrIntMap(dnsZoneLoggingAnonymizationTypesStr)
-
-var dnsZoneLoggingAnonymizationTypeKeys = strIntMapKeysSorted(dnsZoneLoggingAnonymizationTypesStr)
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	bunny "github.com/simplesurance/bunny-go"
+)
+
+const keyApiKey = "api_key"
+
+// Provider returns the legacy SDKv2 provider. It is served through a protocol
+// 6 mux alongside the plugin-framework provider in internal/framework, so
+// resources can be migrated one at a time instead of all at once.
+func Provider(version string) *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			keyApiKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("BUNNY_API_KEY", nil),
+			},
+		},
+
+		ResourcesMap:   map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{},
+
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	apiKey := d.Get(keyApiKey).(string)
+	if apiKey == "" {
+		apiKey = os.Getenv("BUNNY_API_KEY")
+	}
+
+	return bunny.NewClient(apiKey), nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
+	"github.com/swantzter/terraform-provider-bunny/internal/framework"
+	"github.com/swantzter/terraform-provider-bunny/internal/provider"
+)
+
+// version is set via -ldflags at build time by goreleaser.
+var version = "dev"
+
+func main() {
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "start provider in stand-alone debug mode")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	// The SDKv2 provider still serves everything that hasn't been ported to
+	// the plugin framework yet. It's upgraded to protocol 6 so it can be
+	// muxed together with the framework provider below.
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, provider.Provider(version).GRPCProvider)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(framework.New(version)),
+		func() tfprotov6.ProviderServer { return upgradedSdkProvider },
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve(
+		"registry.terraform.io/swantzter/bunny",
+		muxServer.ProviderServer,
+		serveOpts...,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}